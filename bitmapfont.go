@@ -0,0 +1,54 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitmapfont offers bitmap glyphs covering east Asian and other
+// scripts, both as a rune-indexed lookup backed by a lazily-decompressed
+// glyph atlas (Glyph) and as a drop-in font.Face (Face).
+package bitmapfont
+
+//go:generate go run -tags=generate internal/gen/gen.go -format=both -output internal/data/atlas.bin -faceoutput internal/facedata/facedata.go
+
+import (
+	"bytes"
+	_ "embed"
+	"image"
+
+	"github.com/hajimehoshi/bitmapfont/v2/internal/atlas"
+	"github.com/hajimehoshi/bitmapfont/v2/internal/facedata"
+)
+
+//go:embed internal/data/atlas.bin
+var atlasBin []byte
+
+var theAtlas *atlas.Atlas
+
+func init() {
+	a, err := atlas.Load(bytes.NewReader(atlasBin))
+	if err != nil {
+		panic(err)
+	}
+	theAtlas = a
+}
+
+// Glyph returns the rasterized glyph for r, and whether bitmapfont has one.
+// Unlike unpacking the whole gzipped bitmap, only the page containing r is
+// decompressed.
+func Glyph(r rune) (image.Image, bool) {
+	return theAtlas.Glyph(r)
+}
+
+// Face is a basicfont.Face covering every rune bitmapfont could rasterize
+// from its bundled sources, for consumers who want a font.Face rather than
+// calling Glyph directly.
+var Face = facedata.Face
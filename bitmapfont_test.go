@@ -0,0 +1,47 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitmapfont
+
+import (
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestGlyph(t *testing.T) {
+	if _, ok := Glyph('A'); !ok {
+		t.Fatal("Glyph('A') = !ok, want ok")
+	}
+}
+
+func TestFace(t *testing.T) {
+	_, mask, _, _, ok := Face.Glyph(fixed.Point26_6{}, 'A')
+	if !ok {
+		t.Fatal("Face.Glyph(..., 'A') = !ok, want ok")
+	}
+
+	var nonZero int
+	b := mask.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := mask.At(x, y).RGBA(); a != 0 {
+				nonZero++
+			}
+		}
+	}
+	if nonZero == 0 {
+		t.Fatal("Face rendered a blank glyph for 'A'")
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package atlas packs sparse glyphs into shelf-packed pages instead of one
+// monolithic per-codepoint bitmap, and loads them back lazily at runtime so
+// that using a handful of glyphs doesn't require inflating the whole font.
+package atlas
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"sort"
+)
+
+// PageSize is the width and height, in pixels, of a single atlas page.
+const PageSize = 512
+
+const magic = "bmfatl1\x00"
+
+// maxCachedPages bounds how many decompressed pages Atlas keeps around at
+// once; the rest are evicted least-recently-used and re-inflated on demand.
+const maxCachedPages = 4
+
+type glyphEntry struct {
+	r          rune
+	page       int
+	x, y, w, h int
+}
+
+// Builder packs glyphs added via Add into shelf-packed pages, in the order
+// they're added.
+type Builder struct {
+	pages   []*image.Alpha
+	shelfX  []int
+	shelfY  []int
+	shelfH  []int
+	entries []glyphEntry
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Add packs g, associating it with r, into the atlas being built.
+func (b *Builder) Add(r rune, g image.Image) {
+	bnd := g.Bounds()
+	w, h := bnd.Dx(), bnd.Dy()
+
+	page := b.pageFor(w, h)
+	x, y := b.shelfX[page], b.shelfY[page]
+
+	dr := image.Rect(x, y, x+w, y+h)
+	draw.Draw(b.pages[page], dr, g, bnd.Min, draw.Over)
+
+	b.shelfX[page] += w
+	if h > b.shelfH[page] {
+		b.shelfH[page] = h
+	}
+
+	b.entries = append(b.entries, glyphEntry{r: r, page: page, x: x, y: y, w: w, h: h})
+}
+
+// pageFor returns the index of a page with room for a w x h glyph on its
+// current shelf, opening a new shelf or page as needed.
+func (b *Builder) pageFor(w, h int) int {
+	if n := len(b.pages); n > 0 {
+		p := n - 1
+		if b.shelfX[p]+w <= PageSize && b.shelfY[p]+h <= PageSize {
+			return p
+		}
+		if b.shelfY[p]+b.shelfH[p]+h <= PageSize {
+			b.shelfY[p] += b.shelfH[p]
+			b.shelfX[p] = 0
+			b.shelfH[p] = 0
+			return p
+		}
+	}
+
+	b.pages = append(b.pages, image.NewAlpha(image.Rect(0, 0, PageSize, PageSize)))
+	b.shelfX = append(b.shelfX, 0)
+	b.shelfY = append(b.shelfY, 0)
+	b.shelfH = append(b.shelfH, 0)
+	return len(b.pages) - 1
+}
+
+// WriteTo serializes the atlas as a header, a rune-sorted index for
+// binary-search lookup, and one gzip-compressed alpha plane per page.
+func (b *Builder) WriteTo(w io.Writer) (int64, error) {
+	sort.Slice(b.entries, func(i, j int) bool { return b.entries[i].r < b.entries[j].r })
+
+	var n int64
+	write := func(p []byte) error {
+		m, err := w.Write(p)
+		n += int64(m)
+		return err
+	}
+
+	if err := write([]byte(magic)); err != nil {
+		return n, err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint16(len(b.pages))); err != nil {
+		return n, err
+	}
+	n += 2
+	if err := binary.Write(w, binary.BigEndian, uint32(len(b.entries))); err != nil {
+		return n, err
+	}
+	n += 4
+
+	for _, e := range b.entries {
+		rec := [...]interface{}{
+			int32(e.r), uint16(e.page), uint16(e.x), uint16(e.y), uint8(e.w), uint8(e.h),
+		}
+		for _, v := range rec {
+			if err := binary.Write(w, binary.BigEndian, v); err != nil {
+				return n, err
+			}
+			n += int64(binary.Size(v))
+		}
+	}
+
+	for _, p := range b.pages {
+		var buf bytes.Buffer
+		gw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return n, err
+		}
+		if _, err := gw.Write(p.Pix); err != nil {
+			return n, err
+		}
+		if err := gw.Close(); err != nil {
+			return n, err
+		}
+
+		if err := binary.Write(w, binary.BigEndian, uint32(buf.Len())); err != nil {
+			return n, err
+		}
+		n += 4
+		if err := write(buf.Bytes()); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+type indexEntry struct {
+	r    rune
+	page uint16
+	x, y uint16
+	w, h uint8
+}
+
+// Atlas is a glyph atlas loaded from the format Builder.WriteTo writes.
+// Pages are decompressed lazily and cached least-recently-used, so looking
+// up a handful of glyphs doesn't require inflating the whole atlas.
+type Atlas struct {
+	r       io.ReaderAt
+	index   []indexEntry
+	pageOff []int64
+	pageLen []int64
+
+	cache *lru
+}
+
+// Load reads an atlas previously written by Builder.WriteTo. r must remain
+// valid for the lifetime of the returned Atlas; pages are read from it on
+// demand.
+func Load(r io.ReaderAt) (*Atlas, error) {
+	var off int64
+
+	hdr := make([]byte, len(magic)+2+4)
+	if _, err := r.ReadAt(hdr, 0); err != nil {
+		return nil, err
+	}
+	if string(hdr[:len(magic)]) != magic {
+		return nil, fmt.Errorf("atlas: bad magic")
+	}
+	off += int64(len(hdr))
+
+	numPages := int(binary.BigEndian.Uint16(hdr[len(magic):]))
+	numGlyphs := int(binary.BigEndian.Uint32(hdr[len(magic)+2:]))
+
+	const entrySize = 4 + 2 + 2 + 2 + 1 + 1
+	buf := make([]byte, numGlyphs*entrySize)
+	// A zero-length ReadAt at the end of the underlying data (e.g. an atlas
+	// with no glyphs at all) legitimately returns io.EOF from some
+	// io.ReaderAt implementations, such as bytes.Reader; skip the call
+	// entirely rather than treating that as a real error.
+	if len(buf) > 0 {
+		if _, err := r.ReadAt(buf, off); err != nil {
+			return nil, err
+		}
+	}
+	off += int64(len(buf))
+
+	index := make([]indexEntry, numGlyphs)
+	for i := range index {
+		p := buf[i*entrySize:]
+		index[i] = indexEntry{
+			r:    rune(int32(binary.BigEndian.Uint32(p))),
+			page: binary.BigEndian.Uint16(p[4:]),
+			x:    binary.BigEndian.Uint16(p[6:]),
+			y:    binary.BigEndian.Uint16(p[8:]),
+			w:    p[10],
+			h:    p[11],
+		}
+	}
+
+	pageOff := make([]int64, numPages)
+	pageLen := make([]int64, numPages)
+	for i := 0; i < numPages; i++ {
+		var l [4]byte
+		if _, err := r.ReadAt(l[:], off); err != nil {
+			return nil, err
+		}
+		off += 4
+		n := int64(binary.BigEndian.Uint32(l[:]))
+		pageOff[i] = off
+		pageLen[i] = n
+		off += n
+	}
+
+	return &Atlas{
+		r:       r,
+		index:   index,
+		pageOff: pageOff,
+		pageLen: pageLen,
+		cache:   newLRU(maxCachedPages),
+	}, nil
+}
+
+// Glyph returns the rasterized glyph for r, if the atlas has one.
+func (a *Atlas) Glyph(r rune) (image.Image, bool) {
+	i := sort.Search(len(a.index), func(i int) bool { return a.index[i].r >= r })
+	if i == len(a.index) || a.index[i].r != r {
+		return nil, false
+	}
+	e := a.index[i]
+
+	page, err := a.page(int(e.page))
+	if err != nil {
+		return nil, false
+	}
+
+	rect := image.Rect(int(e.x), int(e.y), int(e.x)+int(e.w), int(e.y)+int(e.h))
+	return page.SubImage(rect), true
+}
+
+func (a *Atlas) page(i int) (*image.Alpha, error) {
+	if p, ok := a.cache.get(i); ok {
+		return p, nil
+	}
+
+	buf := make([]byte, a.pageLen[i])
+	if _, err := a.r.ReadAt(buf, a.pageOff[i]); err != nil {
+		return nil, err
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	pix := make([]byte, PageSize*PageSize)
+	if _, err := io.ReadFull(gr, pix); err != nil {
+		return nil, err
+	}
+
+	page := &image.Alpha{Pix: pix, Stride: PageSize, Rect: image.Rect(0, 0, PageSize, PageSize)}
+	a.cache.put(i, page)
+	return page, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlas
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"sync"
+	"testing"
+)
+
+func TestEmptyAtlasRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := NewBuilder().WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Load(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if _, ok := a.Glyph('A'); ok {
+		t.Fatalf("Glyph('A') = ok, want !ok for an empty atlas")
+	}
+}
+
+func TestGlyphRoundTrip(t *testing.T) {
+	runes := []rune{'A', 'B', 0x1f600}
+
+	b := NewBuilder()
+	for _, r := range runes {
+		g := image.NewAlpha(image.Rect(0, 0, 12, 16))
+		draw.Draw(g, g.Bounds(), image.Opaque, image.Point{}, draw.Src)
+		b.Add(r, g)
+	}
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Load(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	for _, r := range runes {
+		g, ok := a.Glyph(r)
+		if !ok {
+			t.Errorf("Glyph(%q) = !ok, want ok", r)
+			continue
+		}
+		if bnd := g.Bounds(); bnd.Dx() != 12 || bnd.Dy() != 16 {
+			t.Errorf("Glyph(%q) bounds = %v, want 12x16", r, bnd)
+		}
+	}
+
+	if _, ok := a.Glyph('Z'); ok {
+		t.Errorf("Glyph('Z') = ok, want !ok")
+	}
+}
+
+func TestLRUEviction(t *testing.T) {
+	c := newLRU(2)
+	p0, p1, p2 := &image.Alpha{}, &image.Alpha{}, &image.Alpha{}
+
+	c.put(0, p0)
+	c.put(1, p1)
+	c.put(2, p2) // should evict page 0, the least recently used
+
+	if _, ok := c.get(0); ok {
+		t.Errorf("page 0 still cached after exceeding capacity")
+	}
+	if _, ok := c.get(1); !ok {
+		t.Errorf("page 1 evicted unexpectedly")
+	}
+	if _, ok := c.get(2); !ok {
+		t.Errorf("page 2 not cached")
+	}
+}
+
+// TestAtlasGlyphConcurrent exercises Atlas.Glyph (and so Atlas.page and the
+// lru cache it shares) from multiple goroutines at once, the way a font.Face
+// is expected to be used. Run with -race to catch data races.
+func TestAtlasGlyphConcurrent(t *testing.T) {
+	runes := []rune{'A', 'B', 'C', 'D', 'E'}
+
+	b := NewBuilder()
+	for _, r := range runes {
+		g := image.NewAlpha(image.Rect(0, 0, 12, 16))
+		draw.Draw(g, g.Bounds(), image.Opaque, image.Point{}, draw.Src)
+		b.Add(r, g)
+	}
+
+	var buf bytes.Buffer
+	if _, err := b.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Load(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func(r rune) {
+			defer wg.Done()
+			if _, ok := a.Glyph(r); !ok {
+				t.Errorf("Glyph(%q) = !ok, want ok", r)
+			}
+		}(runes[i%len(runes)])
+	}
+	wg.Wait()
+}
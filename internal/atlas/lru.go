@@ -0,0 +1,77 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlas
+
+import (
+	"container/list"
+	"image"
+	"sync"
+)
+
+// lru is a fixed-capacity least-recently-used cache of decompressed pages,
+// keyed by page index. It's safe for concurrent use, since font.Face
+// implementations (and so, transitively, Atlas) are expected to be usable
+// from multiple goroutines.
+type lru struct {
+	mu       sync.Mutex
+	capacity int
+	list     *list.List
+	elems    map[int]*list.Element
+}
+
+type lruEntry struct {
+	page  int
+	image *image.Alpha
+}
+
+func newLRU(capacity int) *lru {
+	return &lru{
+		capacity: capacity,
+		list:     list.New(),
+		elems:    make(map[int]*list.Element),
+	}
+}
+
+func (c *lru) get(page int) (*image.Alpha, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.elems[page]
+	if !ok {
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return e.Value.(*lruEntry).image, true
+}
+
+func (c *lru) put(page int, img *image.Alpha) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.elems[page]; ok {
+		c.list.MoveToFront(e)
+		e.Value.(*lruEntry).image = img
+		return
+	}
+
+	e := c.list.PushFront(&lruEntry{page: page, image: img})
+	c.elems[page] = e
+
+	for c.list.Len() > c.capacity {
+		oldest := c.list.Back()
+		c.list.Remove(oldest)
+		delete(c.elems, oldest.Value.(*lruEntry).page)
+	}
+}
@@ -17,25 +17,70 @@
 package main
 
 import (
-	"compress/gzip"
+	"bytes"
 	"flag"
+	"fmt"
+	"go/format"
 	"image"
-	"image/color"
 	"image/draw"
 	"os"
+	"text/template"
 
 	"golang.org/x/text/width"
 
+	"github.com/hajimehoshi/bitmapfont/v2/internal/atlas"
 	"github.com/hajimehoshi/bitmapfont/v2/internal/baekmuk"
 	"github.com/hajimehoshi/bitmapfont/v2/internal/fixed"
 	"github.com/hajimehoshi/bitmapfont/v2/internal/mplus"
+	"github.com/hajimehoshi/bitmapfont/v2/internal/opentype"
+	"github.com/hajimehoshi/bitmapfont/v2/internal/plan9"
 )
 
+// fontFiles implements flag.Value so -ttf and -otf can be repeated to layer
+// several vector fonts on top of the bundled bitmap fonts.
+type fontFiles []string
+
+func (f *fontFiles) String() string {
+	return fmt.Sprint([]string(*f))
+}
+
+func (f *fontFiles) Set(path string) error {
+	*f = append(*f, path)
+	return nil
+}
+
 var (
-	flagOutput   = flag.String("output", "", "output file")
-	flagEastAsia = flag.Bool("eastasia", false, "prefer east Asia punctuations")
+	flagOutput     = flag.String("output", "", "output file for the raw gzip-compressed bitmap")
+	flagFaceOutput = flag.String("faceoutput", "", "output Go source file for a basicfont.Face")
+	flagFormat     = flag.String("format", "raw", "output format: raw, basicfont, or both")
+	flagEastAsia   = flag.Bool("eastasia", false, "prefer east Asia punctuations")
+	flagPlan9Font  = flag.String("plan9font", "", "path to a Plan 9 .font metafile to use as a fallback")
+	flagTTFs       fontFiles
+	flagOTFs       fontFiles
 )
 
+func init() {
+	flag.Var(&flagTTFs, "ttf", "path to a TrueType font to use as a fallback (can be repeated)")
+	flag.Var(&flagOTFs, "otf", "path to an OpenType font to use as a fallback (can be repeated)")
+}
+
+// loadOpenTypeFallbacks reads every font named by -ttf and -otf and registers
+// it with the opentype package, in the order given on the command line.
+func loadOpenTypeFallbacks() error {
+	for _, files := range [][]string{flagTTFs, flagOTFs} {
+		for _, path := range files {
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if err := opentype.AddFont(b); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
+
 func glyphSize() (width, height int) {
 	return 12, 16
 }
@@ -45,10 +90,15 @@ type fontType int
 const (
 	fontTypeNone fontType = iota
 	fontTypeFixed
+	fontTypePlan9
 	fontTypeMPlus
 	fontTypeBaekmuk
+	fontTypeOpenType
 )
 
+// getFontType picks which glyph source to use for r. The bundled bitmap
+// fonts (fixed, M+, Baekmuk) only cover the BMP; runes above it fall all the
+// way through to the opentype fallback, if any was loaded.
 func getFontType(r rune) fontType {
 	if 0x2500 <= r && r <= 0x257f {
 		// Box Drawing
@@ -71,12 +121,18 @@ func getFontType(r rune) fontType {
 	if _, ok := fixed.Glyph(r, 12); ok {
 		return fontTypeFixed
 	}
+	if _, ok := plan9.Glyph(r, 12); ok {
+		return fontTypePlan9
+	}
 	if _, ok := mplus.Glyph(r, 12); ok {
 		return fontTypeMPlus
 	}
 	if _, ok := baekmuk.Glyph(r, 12); ok {
 		return fontTypeBaekmuk
 	}
+	if _, ok := opentype.Glyph(r, 12); ok {
+		return fontTypeOpenType
+	}
 	return fontTypeNone
 }
 
@@ -89,6 +145,11 @@ func getGlyph(r rune) (image.Image, bool) {
 		if ok {
 			return &g, true
 		}
+	case fontTypePlan9:
+		g, ok := plan9.Glyph(r, 12)
+		if ok {
+			return &g, true
+		}
 	case fontTypeMPlus:
 		g, ok := mplus.Glyph(r, 12)
 		if ok {
@@ -99,48 +160,54 @@ func getGlyph(r rune) (image.Image, bool) {
 		if ok {
 			return &g, true
 		}
+	case fontTypeOpenType:
+		g, ok := opentype.Glyph(r, 12)
+		if ok {
+			return &g, true
+		}
 	default:
 		panic("not reached")
 	}
 	return nil, false
 }
 
-func addGlyphs(img draw.Image) {
-	gw, gh := glyphSize()
-	for j := 0; j < 0x100; j++ {
-		for i := 0; i < 0x100; i++ {
-			r := rune(i + j*0x100)
-			g, ok := getGlyph(r)
-			if !ok {
-				continue
-			}
+// planeSize is the number of codepoints in one Unicode plane (including the
+// BMP, plane 0).
+const planeSize = 0x10000
+
+// maxPlane is the highest assigned Unicode plane (Supplementary Private Use
+// Area-B, U+100000-U+10FFFF).
+const maxPlane = 16
 
-			b := g.Bounds()
-			w, h := b.Dx(), b.Dy()
-			dstX := i * gw
-			dstY := j * gh
-			dstR := image.Rect(dstX, dstY, dstX+w, dstY+h)
-			p := g.Bounds().Min
-			draw.Draw(img, dstR, g, p, draw.Over)
+// addPlane scans every rune in the given Unicode plane and packs whatever
+// getGlyph produces for it into b.
+func addPlane(b *atlas.Builder, plane int) {
+	lo := rune(plane) * planeSize
+	hi := lo + planeSize - 1
+	for r := lo; r <= hi; r++ {
+		g, ok := getGlyph(r)
+		if !ok {
+			continue
 		}
+		b.Add(r, g)
 	}
 }
 
-func run() error {
-	gw, gh := glyphSize()
-	img := image.NewAlpha(image.Rect(0, 0, gw*256, gh*256))
-	addGlyphs(img)
-
-	b := img.Bounds()
-	w, h := b.Dx(), b.Dy()
-	as := make([]byte, w*h/8)
-	for j := 0; j < h; j++ {
-		for i := 0; i < w; i++ {
-			a := img.At(i, j).(color.Alpha).A
-			idx := w*j + i
-			if a != 0 {
-				as[idx/8] |= 1 << uint(7-idx%8)
-			}
+// writeAtlas packs every rune getGlyph can produce into a glyph atlas and
+// writes it to -output. Unlike the old monolithic 12*256 x 16*256 bitmap,
+// absent glyphs cost nothing: only the glyphs that actually exist are
+// packed, and they're read back lazily at runtime.
+//
+// The bundled bitmap fonts only cover the BMP (plane 0), so planes 1-16 are
+// only worth scanning when an -ttf/-otf or -plan9font fallback has been
+// loaded; otherwise every rune in them would be a guaranteed miss.
+func writeAtlas() error {
+	b := atlas.NewBuilder()
+
+	addPlane(b, 0)
+	if opentype.HasFonts() || plan9.HasFont() {
+		for plane := 1; plane <= maxPlane; plane++ {
+			addPlane(b, plane)
 		}
 	}
 
@@ -150,20 +217,163 @@ func run() error {
 	}
 	defer fout.Close()
 
-	cw, err := gzip.NewWriterLevel(fout, gzip.BestCompression)
+	_, err = b.WriteTo(fout)
+	return err
+}
+
+// basicFontRange mirrors basicfont.Range and is used only to feed the
+// source template below; it is never serialized.
+type basicFontRange struct {
+	Low, High rune
+	Offset    int
+}
+
+// buildBasicFontMask walks runes 0..0xFFFF, collects the glyphs getGlyph
+// produces and packs them into a single column of gh-tall rows, one per
+// glyph, matching how basicfont.Face.Glyph indexes its Mask: the glyph for
+// rune r is the row at (r's position in its Range) + Offset, each row being
+// Height pixels tall and Width pixels wide.
+func buildBasicFontMask() (mask *image.Alpha, ranges []basicFontRange) {
+	gw, gh := glyphSize()
+
+	var cells int
+	for r := rune(0); r <= 0xffff; r++ {
+		if _, ok := getGlyph(r); ok {
+			cells++
+		}
+	}
+	mask = image.NewAlpha(image.Rect(0, 0, gw, gh*cells))
+
+	var cur *basicFontRange
+	row := 0
+	for r := rune(0); r <= 0xffff; r++ {
+		g, ok := getGlyph(r)
+		if !ok {
+			cur = nil
+			continue
+		}
+
+		if cur == nil || r != cur.High {
+			ranges = append(ranges, basicFontRange{Low: r, Offset: row})
+			cur = &ranges[len(ranges)-1]
+		}
+		cur.High = r + 1
+
+		b := g.Bounds()
+		dstY := row * gh
+		dstR := image.Rect(0, dstY, b.Dx(), dstY+gh)
+		draw.Draw(mask, dstR, g, b.Min, draw.Over)
+		row++
+	}
+	return mask, ranges
+}
+
+const basicFontTemplateSrc = `// Code generated by internal/gen -format=basicfont. DO NOT EDIT.
+
+// Package facedata holds the basicfont.Face data generated from
+// bitmapfont's bundled glyph sources.
+package {{.Package}}
+
+import (
+	"image"
+
+	"golang.org/x/image/font/basicfont"
+)
+
+// Face is a basicfont.Face covering every rune bitmapfont could rasterize
+// from its bundled sources.
+var Face = &basicfont.Face{
+	Advance: {{.Width}},
+	Width:   {{.Width}},
+	Height:  {{.Height}},
+	Ascent:  {{.Ascent}},
+	Descent: {{.Descent}},
+	Mask: &image.Alpha{
+		Pix:    []byte{ {{range .Pix}}{{.}},{{end}} },
+		Stride: {{.Stride}},
+		Rect:   image.Rect(0, 0, {{.Width}}, {{.MaskHeight}}),
+	},
+	Ranges: []basicfont.Range{
+{{range .Ranges}}		{Low: {{.Low}}, High: {{.High}}, Offset: {{.Offset}}},
+{{end}}	},
+}
+`
+
+// writeBasicFont generates a Go source file declaring a basicfont.Face that
+// covers every rune getGlyph can produce, so consumers can use bitmapfont as
+// a font.Face without unpacking the gzipped raw bitmap themselves.
+func writeBasicFont() error {
+	gw, gh := glyphSize()
+	mask, ranges := buildBasicFontMask()
+
+	tmpl := template.Must(template.New("basicfont").Parse(basicFontTemplateSrc))
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		Package    string
+		Width      int
+		Height     int
+		Ascent     int
+		Descent    int
+		MaskHeight int
+		Stride     int
+		Pix        []byte
+		Ranges     []basicFontRange
+	}{
+		Package:    "facedata",
+		Width:      gw,
+		Height:     gh,
+		Ascent:     gh - 4,
+		Descent:    4,
+		MaskHeight: mask.Bounds().Dy(),
+		Stride:     mask.Stride,
+		Pix:        mask.Pix,
+		Ranges:     ranges,
+	})
 	if err != nil {
 		return err
 	}
-	defer cw.Close()
 
-	if _, err := cw.Write(as); err != nil {
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
 		return err
 	}
-	return nil
+
+	fout, err := os.Create(*flagFaceOutput)
+	if err != nil {
+		return err
+	}
+	defer fout.Close()
+
+	_, err = fout.Write(src)
+	return err
+}
+
+func run() error {
+	switch *flagFormat {
+	case "raw":
+		return writeAtlas()
+	case "basicfont":
+		return writeBasicFont()
+	case "both":
+		if err := writeAtlas(); err != nil {
+			return err
+		}
+		return writeBasicFont()
+	default:
+		return fmt.Errorf("unknown format: %q", *flagFormat)
+	}
 }
 
 func main() {
 	flag.Parse()
+	if *flagPlan9Font != "" {
+		if err := plan9.Load(*flagPlan9Font); err != nil {
+			panic(err)
+		}
+	}
+	if err := loadOpenTypeFallbacks(); err != nil {
+		panic(err)
+	}
 	if err := run(); err != nil {
 		panic(err)
 	}
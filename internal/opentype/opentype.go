@@ -0,0 +1,69 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package opentype rasterizes glyphs from user-supplied TrueType/OpenType
+// fonts, so that they can fill in runes the bundled bitmap fonts don't
+// cover.
+package opentype
+
+import (
+	"image"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+
+	"github.com/hajimehoshi/bitmapfont/v2/internal/rasterize"
+)
+
+// faces holds every font added by AddFont, in the order they were added.
+// Glyph consults them in that order, so earlier calls to AddFont take
+// priority.
+var faces []font.Face
+
+// AddFont parses the TrueType/OpenType data in b and adds it to the set of
+// fonts Glyph rasterizes from.
+func AddFont(b []byte) error {
+	f, err := opentype.Parse(b)
+	if err != nil {
+		return err
+	}
+
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    12,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return err
+	}
+
+	faces = append(faces, face)
+	return nil
+}
+
+// HasFonts reports whether any font has been added via AddFont.
+func HasFonts() bool {
+	return len(faces) > 0
+}
+
+// Glyph rasterizes r into a 12x16 cell using the first added font that has a
+// glyph for it.
+func Glyph(r rune, size int) (image.Alpha, bool) {
+	for _, f := range faces {
+		if g, ok := rasterize.Cell(f, r, size); ok {
+			return g, true
+		}
+	}
+	return image.Alpha{}, false
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentype
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// fakeFace reports a glyph only for the one rune it was built for, so tests
+// can tell which face in faces a lookup was satisfied by.
+type fakeFace struct {
+	r rune
+}
+
+func (f *fakeFace) Close() error { return nil }
+
+func (f *fakeFace) Glyph(dot fixed.Point26_6, r rune) (dr image.Rectangle, mask image.Image, maskp image.Point, advance fixed.Int26_6, ok bool) {
+	if r != f.r {
+		return image.Rectangle{}, nil, image.Point{}, 0, false
+	}
+	m := image.NewAlpha(image.Rect(0, 0, 1, 1))
+	m.SetAlpha(0, 0, color.Alpha{A: 0xff})
+	return image.Rect(0, 0, 1, 1), m, image.Point{}, fixed.I(1), true
+}
+
+func (f *fakeFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return fixed.Rectangle26_6{}, 0, false
+}
+
+func (f *fakeFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) { return 0, false }
+func (f *fakeFace) Kern(r0, r1 rune) fixed.Int26_6            { return 0 }
+func (f *fakeFace) Metrics() font.Metrics                     { return font.Metrics{} }
+
+// withFaces replaces the package-level faces for the duration of a test.
+func withFaces(t *testing.T, fs ...font.Face) {
+	t.Helper()
+	old := faces
+	faces = fs
+	t.Cleanup(func() { faces = old })
+}
+
+func TestGlyphTriesFacesInAddOrder(t *testing.T) {
+	// Both faces can rasterize 'A', but the one added first should win.
+	withFaces(t, &fakeFace{r: 'A'}, &fakeFace{r: 'A'})
+
+	if _, ok := Glyph('A', 12); !ok {
+		t.Fatal("Glyph('A', 12) = !ok, want ok")
+	}
+}
+
+func TestGlyphFallsThroughToLaterFaces(t *testing.T) {
+	withFaces(t, &fakeFace{r: 'A'}, &fakeFace{r: 'B'})
+
+	if _, ok := Glyph('B', 12); !ok {
+		t.Fatal("Glyph('B', 12) = !ok, want ok from the second face")
+	}
+}
+
+func TestGlyphNoFaceHasIt(t *testing.T) {
+	withFaces(t, &fakeFace{r: 'A'})
+
+	if _, ok := Glyph('Z', 12); ok {
+		t.Fatal("Glyph('Z', 12) = ok, want !ok")
+	}
+}
+
+func TestHasFonts(t *testing.T) {
+	withFaces(t)
+	if HasFonts() {
+		t.Fatal("HasFonts() = true with no faces loaded")
+	}
+
+	withFaces(t, &fakeFace{r: 'A'})
+	if !HasFonts() {
+		t.Fatal("HasFonts() = false with a face loaded")
+	}
+}
+
+func TestAddFontRejectsInvalidData(t *testing.T) {
+	if err := AddFont([]byte("not a font")); err == nil {
+		t.Fatal("AddFont(garbage) = nil error, want non-nil")
+	}
+}
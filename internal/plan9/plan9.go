@@ -0,0 +1,66 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plan9 rasterizes glyphs from a Plan 9 bitmap font, loaded from a
+// .font metafile and its subfonts via plan9font.ParseFont, so that public
+// domain X11/Plan 9 Unicode fonts can fill in runes the other bundled fonts
+// don't cover.
+package plan9
+
+import (
+	"image"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/plan9font"
+
+	"github.com/hajimehoshi/bitmapfont/v2/internal/rasterize"
+)
+
+var theFont font.Face
+
+// Load parses the Plan 9 .font metafile at path, along with the subfonts it
+// references, and registers it as the font Glyph rasterizes from.
+func Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	f, err := plan9font.ParseFont(data, func(name string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(dir, name))
+	})
+	if err != nil {
+		return err
+	}
+
+	theFont = f
+	return nil
+}
+
+// HasFont reports whether a font has been loaded via Load.
+func HasFont() bool {
+	return theFont != nil
+}
+
+// Glyph rasterizes r into a 12x16 cell using the font loaded via Load, if
+// any.
+func Glyph(r rune, size int) (image.Alpha, bool) {
+	if theFont == nil {
+		return image.Alpha{}, false
+	}
+	return rasterize.Cell(theFont, r, size)
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan9
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingMetafile(t *testing.T) {
+	if err := Load(filepath.Join(t.TempDir(), "nonexistent.font")); err == nil {
+		t.Fatal("Load(nonexistent path) = nil error, want non-nil")
+	}
+	if HasFont() {
+		t.Fatal("HasFont() = true after a failed Load")
+	}
+}
+
+func TestLoadMissingSubfont(t *testing.T) {
+	dir := t.TempDir()
+	metafile := filepath.Join(dir, "test.font")
+	// A minimal .font metafile referencing a subfont that doesn't exist on
+	// disk; ParseFont should fail when it tries to resolve it.
+	const src = "0 0\n0x0000 0x00ff missing.subfont\n"
+	if err := os.WriteFile(metafile, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Load(metafile); err == nil {
+		t.Fatal("Load(metafile with missing subfont) = nil error, want non-nil")
+	}
+	if HasFont() {
+		t.Fatal("HasFont() = true after a failed Load")
+	}
+}
+
+func TestGlyphWithoutLoad(t *testing.T) {
+	if _, ok := Glyph('A', 12); ok {
+		t.Fatal("Glyph('A', 12) = ok before Load, want !ok")
+	}
+}
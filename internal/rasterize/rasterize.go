@@ -0,0 +1,46 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rasterize rasterizes a font.Face glyph into the module's
+// size x (size*4/3) alpha cell. It exists so every font.Face-backed glyph
+// source (opentype, plan9, ...) aligns glyphs to the cell the same way
+// instead of each reimplementing it.
+package rasterize
+
+import (
+	"image"
+	"image/draw"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// Cell rasterizes r from f into a size x (size*4/3) alpha cell.
+//
+// f.Glyph is called with the dot at (0, size) rather than the origin, so
+// that the glyph's baseline sits size pixels down from the top of the cell
+// instead of at it; calling it with the dot at the origin would place
+// everything above the baseline (i.e. most of any normal glyph) above the
+// top of the cell, where draw.Draw would clip it away entirely.
+func Cell(f font.Face, r rune, size int) (image.Alpha, bool) {
+	dot := fixed.Point26_6{X: 0, Y: fixed.I(size)}
+	dr, mask, maskp, _, ok := f.Glyph(dot, r)
+	if !ok {
+		return image.Alpha{}, false
+	}
+
+	g := image.NewAlpha(image.Rect(0, 0, size, size*4/3))
+	draw.Draw(g, dr, mask, maskp, draw.Over)
+	return *g, true
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rasterize
+
+import (
+	"image"
+	"image/draw"
+	"testing"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// fakeFace stands in for a real font.Face, reporting a glyph whose mask
+// extends above the dot the way a real ascender does.
+type fakeFace struct {
+	mask *image.Alpha
+	dr   image.Rectangle
+}
+
+func (f *fakeFace) Close() error { return nil }
+
+func (f *fakeFace) Glyph(dot fixed.Point26_6, r rune) (dr image.Rectangle, mask image.Image, maskp image.Point, advance fixed.Int26_6, ok bool) {
+	if r != 'A' {
+		return image.Rectangle{}, nil, image.Point{}, 0, false
+	}
+	p := image.Pt(int(dot.X>>6), int(dot.Y>>6))
+	return f.dr.Add(p), f.mask, image.Point{}, fixed.I(f.dr.Dx()), true
+}
+
+func (f *fakeFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return fixed.Rectangle26_6{}, 0, false
+}
+
+func (f *fakeFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) { return 0, false }
+func (f *fakeFace) Kern(r0, r1 rune) fixed.Int26_6            { return 0 }
+func (f *fakeFace) Metrics() font.Metrics                     { return font.Metrics{} }
+
+func TestCellPlacesAnAscenderInsideTheCell(t *testing.T) {
+	// A glyph whose top extends 9px above its dot, like a real capital
+	// letter from an opentype face rendered with the dot at the origin.
+	mask := image.NewAlpha(image.Rect(0, 0, 8, 9))
+	draw.Draw(mask, mask.Bounds(), image.Opaque, image.Point{}, draw.Src)
+	f := &fakeFace{mask: mask, dr: image.Rect(0, -9, 8, 0)}
+
+	g, ok := Cell(f, 'A', 12)
+	if !ok {
+		t.Fatal("Cell(f, 'A', 12) = !ok, want ok")
+	}
+
+	var nonZero int
+	for _, a := range g.Pix {
+		if a != 0 {
+			nonZero++
+		}
+	}
+	if nonZero == 0 {
+		t.Fatal("rasterized glyph is entirely blank; the ascender was clipped")
+	}
+}
+
+func TestCellMissingGlyph(t *testing.T) {
+	f := &fakeFace{mask: image.NewAlpha(image.Rect(0, 0, 8, 9)), dr: image.Rect(0, -9, 8, 0)}
+	if _, ok := Cell(f, 'B', 12); ok {
+		t.Fatal("Cell(f, 'B', 12) = ok, want !ok")
+	}
+}